@@ -0,0 +1,264 @@
+package bls12381
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// -------------------------------------
+const (
+	// PrivKeySize is the number of bytes in an encoded BLS12-381 private key.
+	PrivKeySize = 32
+	// PubKeySize is the number of bytes in an encoded BLS12-381 public key
+	// (compressed G1 point).
+	PubKeySize = 48
+	// SignatureSize is the number of bytes in a single BLS12-381 signature
+	// (compressed G2 point).
+	SignatureSize = 96
+
+	// KeyType is the string identifier used for this key type in ABCI and
+	// consensus params. See types.ABCIPubKeyTypeBLS12381.
+	KeyType = "bls12_381"
+)
+
+var (
+	PubKeyName  = "tendermint/PubKeyBLS12_381"
+	PrivKeyName = "tendermint/PrivKeyBLS12_381"
+
+	// dstMinPk is the domain-separation tag used for ordinary vote/commit
+	// signatures under the proof-of-possession ciphersuite.
+	dstMinPk = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+	// dstPop is the domain-separation tag used exclusively for
+	// proof-of-possession signatures (PopProve/PopVerify). It is distinct
+	// from dstMinPk so a PoP can never be replayed as, or confused with, an
+	// ordinary signature over the same bytes.
+	dstPop = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+)
+
+func init() {
+	cmtjson.RegisterType(PubKey{}, PubKeyName)
+	cmtjson.RegisterType(PrivKey{}, PrivKeyName)
+}
+
+// PrivKey is a BLS12-381 private key, serialized as the big-endian scalar.
+type PrivKey []byte
+
+// Bytes returns the privkey byte format.
+func (privKey PrivKey) Bytes() []byte {
+	return []byte(privKey)
+}
+
+// Sign produces a BLS12-381 signature over msg.
+func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
+	sk := new(blst.SecretKey).Deserialize(privKey)
+	if sk == nil {
+		return nil, fmt.Errorf("bls12381: invalid private key")
+	}
+	sig := new(blst.P2Affine).Sign(sk, msg, dstMinPk)
+	return sig.Compress(), nil
+}
+
+// PubKey derives the public key corresponding to this private key.
+func (privKey PrivKey) PubKey() crypto.PubKey {
+	sk := new(blst.SecretKey).Deserialize(privKey)
+	if sk == nil {
+		panic("bls12381: invalid private key")
+	}
+	pk := new(blst.P1Affine).From(sk)
+	return PubKey(pk.Compress())
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKey) Equals(other crypto.PrivKey) bool {
+	otherBLS, ok := other.(PrivKey)
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare(privKey, otherBLS) == 1
+}
+
+func (PrivKey) Type() string {
+	return KeyType
+}
+
+// PopProve produces a proof-of-possession of privKey: a signature, under the
+// dedicated dstPop domain, over privKey's own public key. A validator must
+// submit this alongside its bls12381 pubkey at registration time (genesis or
+// a validator-set update); see PubKey.PopVerify for why this is mandatory
+// before the key may ever be aggregated.
+func (privKey PrivKey) PopProve() ([]byte, error) {
+	sk := new(blst.SecretKey).Deserialize(privKey)
+	if sk == nil {
+		return nil, fmt.Errorf("bls12381: invalid private key")
+	}
+	pk := new(blst.P1Affine).From(sk)
+	sig := new(blst.P2Affine).Sign(sk, pk.Compress(), dstPop)
+	return sig.Compress(), nil
+}
+
+// GenPrivKey generates a new BLS12-381 private key, deriving it from a
+// freshly generated IKM via the KeyGen algorithm of the IETF BLS signature
+// draft (blst.KeyGen).
+func GenPrivKey() PrivKey {
+	var ikm [32]byte
+	if _, err := crypto.CRandom(ikm[:]); err != nil {
+		panic(err)
+	}
+	sk := blst.KeyGen(ikm[:])
+	return PrivKey(sk.Serialize())
+}
+
+// -------------------------------------
+
+// PubKey is a BLS12-381 public key, serialized as a compressed G1 point.
+type PubKey []byte
+
+// Address is the SHA256-20 of the raw pubkey bytes.
+func (pubKey PubKey) Address() crypto.Address {
+	if len(pubKey) != PubKeySize {
+		panic("length of pubkey is incorrect")
+	}
+	return crypto.AddressHash(pubKey)
+}
+
+// Bytes returns the pubkey byte format.
+func (pubKey PubKey) Bytes() []byte {
+	return []byte(pubKey)
+}
+
+// VerifySignature verifies a single BLS12-381 signature over msg.
+func (pubKey PubKey) VerifySignature(msg []byte, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+	pk := new(blst.P1Affine).Uncompress(pubKey)
+	if pk == nil {
+		return false
+	}
+	signature := new(blst.P2Affine).Uncompress(sig)
+	if signature == nil {
+		return false
+	}
+	return signature.Verify(true, pk, true, msg, dstMinPk)
+}
+
+func (pubKey PubKey) String() string {
+	return fmt.Sprintf("PubKeyBLS12_381{%X}", []byte(pubKey))
+}
+
+func (PubKey) Type() string {
+	return KeyType
+}
+
+func (pubKey PubKey) Equals(other crypto.PubKey) bool {
+	otherBLS, ok := other.(PubKey)
+	if !ok {
+		return false
+	}
+	return bytes.Equal(pubKey, otherBLS)
+}
+
+// PopVerify checks a proof-of-possession produced by PrivKey.PopProve.
+//
+// FastAggregateVerify (used by VerifyAggregateSignature) is vulnerable to a
+// rogue-key attack unless every contributing pubkey has separately proven
+// possession of its private key: without that check, an attacker who
+// controls one key can pick a crafted "public key" that makes an aggregate
+// signature verify as if another validator had signed, without ever
+// knowing that validator's private key. Genesis and validator-set-update
+// code MUST call PopVerify for every bls12381 validator pubkey and reject
+// the key outright if it fails, before the key is ever admitted to a set
+// that VerifyAggregateSignature/AggregateSignatures will be used against.
+// See ValidatedPubKey, which enforces this at construction time.
+func (pubKey PubKey) PopVerify(proof []byte) bool {
+	pk := new(blst.P1Affine).Uncompress(pubKey)
+	if pk == nil {
+		return false
+	}
+	sig := new(blst.P2Affine).Uncompress(proof)
+	if sig == nil {
+		return false
+	}
+	return sig.Verify(true, pk, true, pubKey.Bytes(), dstPop)
+}
+
+// ValidatedPubKey wraps a PubKey whose proof-of-possession has already been
+// checked. It is the only way to obtain a value accepted by
+// VerifyAggregateSignature, so that function can never be called with a
+// pubkey that hasn't been vetted against the rogue-key attack described on
+// PopVerify.
+type ValidatedPubKey struct {
+	pubKey PubKey
+}
+
+// NewValidatedPubKey checks proof against pubKey's proof-of-possession and,
+// if it passes, returns a ValidatedPubKey wrapping it. Callers (genesis
+// validator admission, validator-set updates) must reject pubKey outright
+// if the returned error is non-nil; the key must not be stored or otherwise
+// trusted in that case.
+func NewValidatedPubKey(pubKey PubKey, proof []byte) (ValidatedPubKey, error) {
+	if !pubKey.PopVerify(proof) {
+		return ValidatedPubKey{}, fmt.Errorf("bls12381: proof-of-possession verification failed for pubkey %s", pubKey)
+	}
+	return ValidatedPubKey{pubKey: pubKey}, nil
+}
+
+// AggregateSignatures combines signatures that were each produced over the
+// same message into a single constant-size aggregate signature. It is the
+// caller's responsibility to ensure every input signature was produced over
+// an identical payload (e.g. the same vote SignBytes) before combining them:
+// aggregation does not, by itself, bind the signatures to particular signers.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, fmt.Errorf("bls12381: no signatures to aggregate")
+	}
+	points := make([]*blst.P2Affine, len(sigs))
+	for i, sig := range sigs {
+		p := new(blst.P2Affine).Uncompress(sig)
+		if p == nil {
+			return nil, fmt.Errorf("bls12381: invalid signature at index %d", i)
+		}
+		points[i] = p
+	}
+	agg := new(blst.P2Aggregate)
+	if !agg.Aggregate(points, true) {
+		return nil, fmt.Errorf("bls12381: failed to aggregate signatures")
+	}
+	return agg.ToAffine().Compress(), nil
+}
+
+// VerifyAggregateSignature checks an aggregate signature produced by
+// AggregateSignatures against the set of public keys that contributed to
+// it, all of which must have signed the identical msg (e.g. a common
+// commit SignBytes shared by a supermajority of a validator set).
+//
+// pubKeys must be ValidatedPubKey values (see NewValidatedPubKey): this
+// function relies on blst's FastAggregateVerify, which is only safe against
+// the rogue-key attack once every contributing key has separately proven
+// possession of its private key. There is deliberately no variant of this
+// function that accepts a raw []PubKey.
+func VerifyAggregateSignature(pubKeys []ValidatedPubKey, msg []byte, aggSig []byte) bool {
+	if len(pubKeys) == 0 {
+		return false
+	}
+	signature := new(blst.P2Affine).Uncompress(aggSig)
+	if signature == nil {
+		return false
+	}
+	pks := make([]*blst.P1Affine, len(pubKeys))
+	for i, pk := range pubKeys {
+		p := new(blst.P1Affine).Uncompress(pk.pubKey)
+		if p == nil {
+			return false
+		}
+		pks[i] = p
+	}
+	return signature.FastAggregateVerify(true, pks, msg, dstMinPk)
+}
@@ -0,0 +1,83 @@
+package bls12381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/bls12381"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey().(bls12381.PubKey)
+
+	msg := []byte("hello world")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+	require.False(t, pubKey.VerifySignature([]byte("other message"), sig))
+}
+
+func TestPopProveAndVerify(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey().(bls12381.PubKey)
+
+	proof, err := privKey.PopProve()
+	require.NoError(t, err)
+	require.True(t, pubKey.PopVerify(proof))
+
+	other := bls12381.GenPrivKey()
+	otherProof, err := other.PopProve()
+	require.NoError(t, err)
+	require.False(t, pubKey.PopVerify(otherProof), "a proof for a different key must not verify")
+}
+
+func TestNewValidatedPubKeyRejectsMissingPop(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey().(bls12381.PubKey)
+
+	// An ordinary signature over the pubkey's own bytes is not a valid
+	// proof-of-possession: the dedicated PoP domain-separation tag keeps
+	// the two from being interchangeable.
+	forgedProof, err := privKey.Sign(pubKey.Bytes())
+	require.NoError(t, err)
+
+	_, err = bls12381.NewValidatedPubKey(pubKey, forgedProof)
+	require.Error(t, err)
+}
+
+func TestAggregateSignatureRequiresValidatedPubKeys(t *testing.T) {
+	msg := []byte("commit sign bytes")
+
+	const n = 4
+	privKeys := make([]bls12381.PrivKey, n)
+	validated := make([]bls12381.ValidatedPubKey, n)
+	sigs := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		privKeys[i] = bls12381.GenPrivKey()
+		pubKey := privKeys[i].PubKey().(bls12381.PubKey)
+
+		proof, err := privKeys[i].PopProve()
+		require.NoError(t, err)
+		vpk, err := bls12381.NewValidatedPubKey(pubKey, proof)
+		require.NoError(t, err)
+		validated[i] = vpk
+
+		sig, err := privKeys[i].Sign(msg)
+		require.NoError(t, err)
+		sigs[i] = sig
+	}
+
+	aggSig, err := bls12381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.True(t, bls12381.VerifyAggregateSignature(validated, msg, aggSig))
+
+	// A signature that doesn't belong to the aggregate must fail.
+	wrongKey := bls12381.GenPrivKey()
+	wrongSig, err := wrongKey.Sign(msg)
+	require.NoError(t, err)
+	tamperedAgg, err := bls12381.AggregateSignatures(append(append([][]byte{}, sigs[1:]...), wrongSig))
+	require.NoError(t, err)
+	require.False(t, bls12381.VerifyAggregateSignature(validated, msg, tamperedAgg))
+}
@@ -1,12 +1,17 @@
 package types
 
 import (
+	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 )
@@ -23,11 +28,13 @@ const (
 
 	ABCIPubKeyTypeEd25519   = ed25519.KeyType
 	ABCIPubKeyTypeSecp256k1 = secp256k1.KeyType
+	ABCIPubKeyTypeBLS12381  = bls12381.KeyType
 )
 
 var ABCIPubKeyTypesToNames = map[string]string{
 	ABCIPubKeyTypeEd25519:   ed25519.PubKeyName,
 	ABCIPubKeyTypeSecp256k1: secp256k1.PubKeyName,
+	ABCIPubKeyTypeBLS12381:  bls12381.PubKeyName,
 }
 
 // ConsensusParams contains consensus critical parameters that determine the
@@ -40,6 +47,83 @@ type ConsensusParams struct {
 	ABCI      ABCIParams      `json:"abci"`
 	Synchrony SynchronyParams `json:"synchrony"`
 	PBTS      PBTSParams      `json:"pbts"`
+	Feature   FeatureParams   `json:"feature"`
+}
+
+// consensusParamsJSON mirrors the JSON encoding of ConsensusParams. It keeps
+// the legacy "abci":{"vote_extensions_enable_height":...} and
+// "pbts":{"pbts_enable_height":...} shapes that genesis.json and the RPC
+// have always used, alongside the canonical "feature" map, instead of
+// letting ABCIParams/PBTSParams (now empty structs) speak for themselves.
+type consensusParamsJSON struct {
+	Block     BlockParams     `json:"block"`
+	Evidence  EvidenceParams  `json:"evidence"`
+	Validator ValidatorParams `json:"validator"`
+	Version   VersionParams   `json:"version"`
+	ABCI      struct {
+		VoteExtensionsEnableHeight int64 `json:"vote_extensions_enable_height,omitempty"`
+	} `json:"abci"`
+	Synchrony SynchronyParams `json:"synchrony"`
+	PBTS      struct {
+		PBTSEnableHeight int64 `json:"pbts_enable_height,omitempty"`
+	} `json:"pbts"`
+	Feature FeatureParams `json:"feature,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+//
+// ABCIParams and PBTSParams no longer carry the enable-height fields that
+// genesis.json and the RPC have always encoded at
+// "abci.vote_extensions_enable_height" and "pbts.pbts_enable_height": left
+// to plain struct tags, those keys would vanish from the output entirely.
+// This mirrors them out of Feature so that a node that hasn't learned about
+// "feature" yet (an older CLI, an external indexer) still sees the heights
+// it expects.
+func (params ConsensusParams) MarshalJSON() ([]byte, error) {
+	aux := consensusParamsJSON{
+		Block:     params.Block,
+		Evidence:  params.Evidence,
+		Validator: params.Validator,
+		Version:   params.Version,
+		Synchrony: params.Synchrony,
+		Feature:   params.Feature,
+	}
+	aux.ABCI.VoteExtensionsEnableHeight = params.Feature[FeatureVoteExtensions]
+	aux.PBTS.PBTSEnableHeight = params.Feature[FeaturePBTS]
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// A genesis.json written before FeatureParams existed only has
+// "abci.vote_extensions_enable_height" and "pbts.pbts_enable_height", not
+// "feature". Without this, encoding/json would silently drop those unknown
+// legacy keys and every upgraded node would load the chain with vote
+// extensions and PBTS reset to disabled. The legacy keys are merged into
+// Feature exactly like ConsensusParamsFromProto does for the equivalent
+// proto fields: Feature wins whenever both are present.
+func (params *ConsensusParams) UnmarshalJSON(data []byte) error {
+	var aux consensusParamsJSON
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	params.Block = aux.Block
+	params.Evidence = aux.Evidence
+	params.Validator = aux.Validator
+	params.Version = aux.Version
+	params.Synchrony = aux.Synchrony
+
+	params.Feature = aux.Feature
+	if params.Feature == nil {
+		params.Feature = FeatureParams{}
+	}
+	if _, ok := params.Feature[FeatureVoteExtensions]; !ok {
+		params.Feature[FeatureVoteExtensions] = aux.ABCI.VoteExtensionsEnableHeight
+	}
+	if _, ok := params.Feature[FeaturePBTS]; !ok {
+		params.Feature[FeaturePBTS] = aux.PBTS.PBTSEnableHeight
+	}
+	return nil
 }
 
 // BlockParams define limits on the block size and gas plus minimum time
@@ -47,6 +131,23 @@ type ConsensusParams struct {
 type BlockParams struct {
 	MaxBytes int64 `json:"max_bytes"`
 	MaxGas   int64 `json:"max_gas"`
+	// Lanes optionally reserves a portion of MaxBytes/MaxGas for named
+	// mempool lanes (e.g. "oracle", "ibc", "user"). A nil or empty Lanes
+	// means no lane reservations are configured. ValidateBasic checks the
+	// lanes against MaxBytes/MaxGas; nothing in this package reserves or
+	// enforces the lane budgets when a block is built.
+	Lanes []LaneParams `json:"lanes,omitempty"`
+}
+
+// LaneParams reserves a portion of a block's byte/gas budget for a named
+// mempool lane. Priority is carried through validation, Update and the proto
+// encoding unchanged; nothing in this package orders lanes or transactions
+// by it.
+type LaneParams struct {
+	Name     string `json:"name"`
+	MaxBytes int64  `json:"max_bytes"`
+	MaxGas   int64  `json:"max_gas"`
+	Priority uint32 `json:"priority"`
 }
 
 // EvidenceParams determine how we handle evidence of malfeasance.
@@ -58,46 +159,105 @@ type EvidenceParams struct {
 
 // ValidatorParams restrict the public key types validators can use.
 // NOTE: uses ABCI pubkey naming, not Amino names.
+// Chains may enable ABCIPubKeyTypeBLS12381 via governance alongside (or
+// instead of) ed25519/secp256k1 to allow aggregated commit signatures.
+// ValidateBasic only checks that PubKeyTypes names a known pubkey type; it
+// has no access to an individual validator's actual key bytes, so it cannot
+// by itself stop a bls12_381 key from being admitted without a
+// proof-of-possession check. Every call site that admits a validator's
+// pubkey (genesis construction, an ABCI ValidatorUpdate) MUST additionally
+// go through ValidateValidatorPubKeyUpdate, which enforces that check, or
+// the rogue-key attack that bls12381.NewValidatedPubKey/
+// VerifyAggregateSignature exist to close is reopened.
 type ValidatorParams struct {
 	PubKeyTypes []string `json:"pub_key_types"`
 }
 
+// ValidateValidatorPubKeyUpdate checks that pubKey of type pubKeyType is
+// permitted to be admitted to a validator set under params — at genesis, or
+// via a subsequent ABCI ValidatorUpdate. Beyond IsValidPubkeyType, a
+// bls12_381 key additionally MUST supply pop, a proof-of-possession of
+// pubKey verified via bls12381.NewValidatedPubKey; without it, the key must
+// be rejected outright rather than merely warned about, since an
+// unvalidated bls12_381 key can be crafted to defeat
+// bls12381.VerifyAggregateSignature's rogue-key protection for every other
+// validator in the set. pop is ignored for every other pubKeyType.
+func ValidateValidatorPubKeyUpdate(params ValidatorParams, pubKeyType string, pubKey, pop []byte) error {
+	if !IsValidPubkeyType(params, pubKeyType) {
+		return fmt.Errorf("pubkey type %s is not a valid type for validators", pubKeyType)
+	}
+	if pubKeyType == ABCIPubKeyTypeBLS12381 {
+		if _, err := bls12381.NewValidatedPubKey(bls12381.PubKey(pubKey), pop); err != nil {
+			return fmt.Errorf("bls12_381 validator pubkey rejected: %w", err)
+		}
+	}
+	return nil
+}
+
 type VersionParams struct {
 	App uint64 `json:"app"`
 }
 
 // ABCIParams configure ABCI functionality specific to the Application Blockchain
-// Interface.
-type ABCIParams struct {
-	VoteExtensionsEnableHeight int64 `json:"vote_extensions_enable_height"`
-}
+// Interface. Feature-gated settings, such as the vote extensions activation
+// height, now live in FeatureParams; this struct is kept for ABCI settings
+// that are not governed by an activation height.
+type ABCIParams struct{}
 
 // VoteExtensionsEnabled returns true if vote extensions are enabled at height h
 // and false otherwise.
-func (a ABCIParams) VoteExtensionsEnabled(h int64) bool {
-	if h < 1 {
-		panic(fmt.Errorf("cannot check if vote extensions enabled for height %d (< 1)", h))
-	}
-	if a.VoteExtensionsEnableHeight == 0 {
-		return false
-	}
-	return a.VoteExtensionsEnableHeight <= h
+func (params ConsensusParams) VoteExtensionsEnabled(h int64) bool {
+	return params.Feature.Enabled(FeatureVoteExtensions, h)
 }
 
-type PBTSParams struct {
-	PBTSEnableHeight int64 `json:"pbts_enable_height"`
-}
+// PBTSParams is kept for PBTS settings that are not governed by an
+// activation height. The activation height itself lives in FeatureParams.
+type PBTSParams struct{}
 
 // PBTSEnabled returns true if PBTS are enabled at height h
 // and false otherwise.
-func (p PBTSParams) PBTSEnabled(h int64) bool {
+func (params ConsensusParams) PBTSEnabled(h int64) bool {
+	return params.Feature.Enabled(FeaturePBTS, h)
+}
+
+const (
+	// FeatureVoteExtensions is the feature name gating ABCI vote extensions.
+	FeatureVoteExtensions = "vote_extensions"
+	// FeaturePBTS is the feature name gating proposer-based timestamps.
+	FeaturePBTS = "pbts"
+	// FeatureMerkleizedParamsHash gates the switch from the v1 (legacy
+	// HashedParams) to the v2 (merkleized) ConsensusParams.Hash scheme. See
+	// HashAtHeight.
+	FeatureMerkleizedParamsHash = "merkleized_params_hash"
+)
+
+// FeatureParams governs the activation heights of consensus features that
+// chains can turn on via governance, keyed by a well-known feature name
+// (e.g. FeatureVoteExtensions, FeaturePBTS). It generalizes the
+// VoteExtensionsEnableHeight / PBTSEnableHeight pattern so that new
+// features (new signature schemes, new mempool lanes, new evidence types,
+// ...) can be gated by height without adding a new params struct, validator
+// and updater every time.
+//
+// A height of 0 (or a missing entry) means the feature is disabled. The
+// validation rules mirror what previously applied to each individual
+// enable-height field: a feature cannot be disabled once enabled, cannot be
+// set to a past height, and cannot be modified once its activation height
+// has occurred.
+type FeatureParams map[string]int64
+
+// Enabled returns true if the named feature is enabled at height h and
+// false otherwise. It panics if h is less than 1, consistent with the
+// height validity checks performed elsewhere on enable heights.
+func (f FeatureParams) Enabled(name string, h int64) bool {
 	if h < 1 {
-		panic(fmt.Errorf("cannot check if PBTS enabled for height %d (< 1)", h))
+		panic(fmt.Errorf("cannot check if feature %q is enabled for height %d (< 1)", name, h))
 	}
-	if p.PBTSEnableHeight == 0 {
+	height, ok := f[name]
+	if !ok || height == 0 {
 		return false
 	}
-	return p.PBTSEnableHeight <= h
+	return height <= h
 }
 
 // SynchronyParams influence the validity of block timestamps.
@@ -119,6 +279,7 @@ func DefaultConsensusParams() *ConsensusParams {
 		ABCI:      DefaultABCIParams(),
 		Synchrony: DefaultSynchronyParams(),
 		PBTS:      DefaultPBTSParams(),
+		Feature:   DefaultFeatureParams(),
 	}
 }
 
@@ -154,10 +315,7 @@ func DefaultVersionParams() VersionParams {
 }
 
 func DefaultABCIParams() ABCIParams {
-	return ABCIParams{
-		// When set to 0, vote extensions are not required.
-		VoteExtensionsEnableHeight: 0,
-	}
+	return ABCIParams{}
 }
 
 func DefaultSynchronyParams() SynchronyParams {
@@ -171,9 +329,56 @@ func DefaultSynchronyParams() SynchronyParams {
 
 // Disabled by default.
 func DefaultPBTSParams() PBTSParams {
-	return PBTSParams{
-		PBTSEnableHeight: 0,
+	return PBTSParams{}
+}
+
+// DefaultFeatureParams returns a FeatureParams with every known feature
+// disabled (activation height 0).
+func DefaultFeatureParams() FeatureParams {
+	return FeatureParams{
+		FeatureVoteExtensions:       0,
+		FeaturePBTS:                 0,
+		FeatureMerkleizedParamsHash: 0,
+	}
+}
+
+// validateLaneParams ensures lane names are unique and that the sum of the
+// lanes' reserved budgets does not exceed the block's overall MaxBytes/MaxGas.
+func validateLaneParams(block BlockParams) error {
+	if len(block.Lanes) == 0 {
+		return nil
+	}
+
+	seenNames := make(map[string]struct{}, len(block.Lanes))
+	var sumBytes, sumGas int64
+	for _, lane := range block.Lanes {
+		if lane.Name == "" {
+			return errors.New("block.Lanes: lane name must not be empty")
+		}
+		if _, ok := seenNames[lane.Name]; ok {
+			return fmt.Errorf("block.Lanes: duplicate lane name %q", lane.Name)
+		}
+		seenNames[lane.Name] = struct{}{}
+
+		if lane.MaxBytes < 0 {
+			return fmt.Errorf("block.Lanes[%s].MaxBytes must be non-negative. Got %d", lane.Name, lane.MaxBytes)
+		}
+		if lane.MaxGas < 0 {
+			return fmt.Errorf("block.Lanes[%s].MaxGas must be non-negative. Got %d", lane.Name, lane.MaxGas)
+		}
+		sumBytes += lane.MaxBytes
+		sumGas += lane.MaxGas
+	}
+
+	if block.MaxBytes != -1 && sumBytes > block.MaxBytes {
+		return fmt.Errorf("sum of block.Lanes MaxBytes is greater than block.MaxBytes, %d > %d",
+			sumBytes, block.MaxBytes)
 	}
+	if block.MaxGas != -1 && sumGas > block.MaxGas {
+		return fmt.Errorf("sum of block.Lanes MaxGas is greater than block.MaxGas, %d > %d",
+			sumGas, block.MaxGas)
+	}
+	return nil
 }
 
 func IsValidPubkeyType(params ValidatorParams, pubkeyType string) bool {
@@ -206,6 +411,10 @@ func (params ConsensusParams) ValidateBasic() error {
 			params.Block.MaxGas)
 	}
 
+	if err := validateLaneParams(params.Block); err != nil {
+		return err
+	}
+
 	if params.Evidence.MaxAgeNumBlocks <= 0 {
 		return fmt.Errorf("evidence.MaxAgeNumBlocks must be greater than 0. Got %d",
 			params.Evidence.MaxAgeNumBlocks)
@@ -230,8 +439,10 @@ func (params ConsensusParams) ValidateBasic() error {
 			params.Evidence.MaxBytes)
 	}
 
-	if params.ABCI.VoteExtensionsEnableHeight < 0 {
-		return fmt.Errorf("ABCI.VoteExtensionsEnableHeight cannot be negative. Got: %d", params.ABCI.VoteExtensionsEnableHeight)
+	for name, height := range params.Feature {
+		if height < 0 {
+			return fmt.Errorf("feature.%s enable height cannot be negative. Got: %d", name, height)
+		}
 	}
 
 	if params.Synchrony.MessageDelay <= 0 {
@@ -244,10 +455,6 @@ func (params ConsensusParams) ValidateBasic() error {
 			params.Synchrony.Precision)
 	}
 
-	if params.PBTS.PBTSEnableHeight < 0 {
-		return fmt.Errorf("PBTS.PBTSEnableHeight must not be negative. Got: %d", params.PBTS.PBTSEnableHeight)
-	}
-
 	if len(params.Validator.PubKeyTypes) == 0 {
 		return errors.New("len(Validator.PubKeyTypes) must be greater than 0")
 	}
@@ -265,60 +472,97 @@ func (params ConsensusParams) ValidateBasic() error {
 }
 
 func (params ConsensusParams) ValidateUpdate(updated *cmtproto.ConsensusParams, h int64) error {
-	var err error
-	// Validate ABCI Update
+	requested := map[string]int64{}
 	if updated.Abci != nil {
-		if err = validateUpdateABCI(params, updated, h); err != nil {
-			return err
-		}
+		requested[FeatureVoteExtensions] = updated.Abci.VoteExtensionsEnableHeight
 	}
-
-	// Validate PBTS Update
 	if updated.Pbts != nil {
-		err = validateUpdatePBTS(params, updated, h)
+		requested[FeaturePBTS] = updated.Pbts.PbtsEnableHeight
 	}
-	return err
+	for _, fp := range updated.Features {
+		requested[fp.Name] = fp.Height
+	}
+
+	names := make([]string, 0, len(requested))
+	for name := range requested {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := validateUpdateFeature(params.Feature, name, requested[name], h); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func validateUpdateABCI(params ConsensusParams, updated *cmtproto.ConsensusParams, h int64) error {
-	if params.ABCI.VoteExtensionsEnableHeight == updated.Abci.VoteExtensionsEnableHeight {
+// validateUpdateFeature applies one unified activation-height update rule to
+// every entry in FeatureParams: a feature cannot be disabled once enabled,
+// its activation height cannot be set to a past height, and it cannot be
+// modified once its activation height has occurred.
+//
+// This is intentionally the more permissive of the two rules it replaces.
+// Before FeatureParams existed, validateUpdateABCI already allowed
+// resubmitting the same still-future height or moving it to a different
+// still-future height, but validateUpdatePBTS rejected any update at all
+// once PBTSEnableHeight was non-zero, even to the same value or to a later
+// height that hadn't occurred yet. Unifying onto the ABCI rule is a real
+// loosening of PBTS update semantics, not merely a restatement of rules
+// that already matched.
+func validateUpdateFeature(current FeatureParams, name string, newHeight, h int64) error {
+	oldHeight := current[name]
+	if oldHeight == newHeight {
 		return nil
 	}
-	if params.ABCI.VoteExtensionsEnableHeight != 0 && updated.Abci.VoteExtensionsEnableHeight == 0 {
-		return errors.New("vote extensions cannot be disabled once enabled")
+	if oldHeight != 0 && newHeight == 0 {
+		return fmt.Errorf("%s cannot be disabled once enabled", name)
 	}
-	if updated.Abci.VoteExtensionsEnableHeight <= h {
-		return fmt.Errorf("VoteExtensionsEnableHeight cannot be updated to a past height, "+
-			"initial height: %d, current height %d",
-			params.ABCI.VoteExtensionsEnableHeight, h)
+	if newHeight <= h {
+		return fmt.Errorf("%s enable height cannot be updated to a past height, "+
+			"current enable height: %d, current height %d",
+			name, oldHeight, h)
 	}
-	if params.ABCI.VoteExtensionsEnableHeight <= h {
-		return fmt.Errorf("VoteExtensionsEnableHeight cannot be modified once"+
-			"the initial height has occurred, "+
-			"initial height: %d, current height %d",
-			params.ABCI.VoteExtensionsEnableHeight, h)
+	if oldHeight != 0 && oldHeight <= h {
+		return fmt.Errorf("%s enable height cannot be modified once "+
+			"the enable height has occurred, "+
+			"enable height: %d, current height %d",
+			name, oldHeight, h)
 	}
 	return nil
 }
 
-func validateUpdatePBTS(params ConsensusParams, updated *cmtproto.ConsensusParams, h int64) error {
-	if params.PBTS.PBTSEnableHeight != 0 {
-		return errors.New("pbts already enabled")
-	}
+// paramsHashFields lists, in a fixed order, the sections hashed into
+// ConsensusParams.Hash under the v2 (merkleized) scheme. The order is part
+// of the hash scheme and must not change once a chain has activated v2.
+var paramsHashFields = []string{
+	"Block", "Evidence", "Validator", "Version", "ABCI", "Synchrony", "PBTS", "Feature",
+}
 
-	if updated.Pbts.PbtsEnableHeight <= h {
-		return fmt.Errorf("PbtsEnableHeight cannot be updated to a past height, "+
-			"pbts enabled height: %d, current height %d",
-			params.PBTS.PBTSEnableHeight, h)
-	}
-	return nil
+// Hash returns the ConsensusParams hash to store in Header.ConsensusHash at
+// height h. This is the only hashing entrypoint: header construction must
+// call Hash(h), not a separate height-less variant, so the version switch
+// below is never bypassable by an older call site.
+//
+// Until FeatureMerkleizedParamsHash activates at or before h, this returns
+// the legacy v1 hash: a hash of a subset of the parameters (only
+// Block.MaxBytes and Block.MaxGas). Once activated, it returns the v2
+// merkleized hash (see hashLeaves), which covers every subsection. Gating
+// the switch behind an activation height, rather than always using v2,
+// ensures existing chains don't fork on upgrade.
+func (params ConsensusParams) Hash(h int64) []byte {
+	if !params.Feature.Enabled(FeatureMerkleizedParamsHash, h) {
+		return params.hashV1()
+	}
+	root, _ := merkle.ProofsFromByteSlices(params.hashLeaves())
+	return root
 }
 
-// Hash returns a hash of a subset of the parameters to store in the block header.
-// Only the Block.MaxBytes and Block.MaxGas are included in the hash.
-// This allows the ConsensusParams to evolve more without breaking the block
-// protocol. No need for a Merkle tree here, just a small struct to hash.
-func (params ConsensusParams) Hash() []byte {
+// hashV1 is the legacy ConsensusParams hash scheme: only Block.MaxBytes and
+// Block.MaxGas are included. This allows the ConsensusParams to evolve more
+// without breaking the block protocol. No need for a Merkle tree here, just
+// a small struct to hash.
+func (params ConsensusParams) hashV1() []byte {
 	hasher := tmhash.New()
 
 	hp := cmtproto.HashedParams{
@@ -338,6 +582,75 @@ func (params ConsensusParams) Hash() []byte {
 	return hasher.Sum(nil)
 }
 
+// HashWithProof returns the v2 merkleized hash of params together with a
+// Merkle proof that the named field (one of paramsHashFields, e.g. "Block"
+// or "Synchrony") is included in that hash. It allows a light client to
+// verify a single parameter value (e.g. the active Synchrony.MessageDelay
+// or PubKeyTypes set) against a block header without downloading the full
+// ConsensusParams.
+func (params ConsensusParams) HashWithProof(field string) ([]byte, *merkle.Proof, error) {
+	idx := -1
+	for i, name := range paramsHashFields {
+		if name == field {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return nil, nil, fmt.Errorf("unknown ConsensusParams hash field %q", field)
+	}
+
+	leaves := params.hashLeaves()
+	root, proofs := merkle.ProofsFromByteSlices(leaves)
+	return root, proofs[idx], nil
+}
+
+// hashLeaves canonically encodes each section of params, in the fixed order
+// given by paramsHashFields, for use as the leaves of the v2 Merkle hash.
+func (params ConsensusParams) hashLeaves() [][]byte {
+	mustMarshal := func(m interface{ Marshal() ([]byte, error) }) []byte {
+		bz, err := m.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		return bz
+	}
+
+	pb := params.ToProto()
+	return [][]byte{
+		mustMarshal(pb.Block),
+		mustMarshal(pb.Evidence),
+		mustMarshal(pb.Validator),
+		mustMarshal(pb.Version),
+		mustMarshal(pb.Abci),
+		mustMarshal(pb.Synchrony),
+		mustMarshal(pb.Pbts),
+		params.Feature.canonicalBytes(),
+	}
+}
+
+// canonicalBytes deterministically encodes f as length-prefixed
+// name/height pairs, sorted by feature name, so that map iteration order
+// never affects the resulting hash.
+func (f FeatureParams) canonicalBytes() []byte {
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var bz []byte
+	for _, name := range names {
+		var lenBuf, heightBuf [8]byte
+		binary.BigEndian.PutUint64(lenBuf[:], uint64(len(name)))
+		binary.BigEndian.PutUint64(heightBuf[:], uint64(f[name]))
+		bz = append(bz, lenBuf[:]...)
+		bz = append(bz, name...)
+		bz = append(bz, heightBuf[:]...)
+	}
+	return bz
+}
+
 // Update returns a copy of the params with updates from the non-zero fields of p2.
 // NOTE: note: must not modify the original.
 func (params ConsensusParams) Update(params2 *cmtproto.ConsensusParams) ConsensusParams {
@@ -351,6 +664,11 @@ func (params ConsensusParams) Update(params2 *cmtproto.ConsensusParams) Consensu
 	if params2.Block != nil {
 		res.Block.MaxBytes = params2.Block.MaxBytes
 		res.Block.MaxGas = params2.Block.MaxGas
+		// nil Lanes means "no change" so that updates which don't touch
+		// lane reservations don't have to repeat them.
+		if params2.Block.Lanes != nil {
+			res.Block.Lanes = lanesFromProto(params2.Block.Lanes)
+		}
 	}
 	if params2.Evidence != nil {
 		res.Evidence.MaxAgeNumBlocks = params2.Evidence.MaxAgeNumBlocks
@@ -366,7 +684,7 @@ func (params ConsensusParams) Update(params2 *cmtproto.ConsensusParams) Consensu
 		res.Version.App = params2.Version.App
 	}
 	if params2.Abci != nil {
-		res.ABCI.VoteExtensionsEnableHeight = params2.Abci.GetVoteExtensionsEnableHeight()
+		res.Feature = res.Feature.withHeight(FeatureVoteExtensions, params2.Abci.GetVoteExtensionsEnableHeight())
 	}
 	if params2.Synchrony != nil {
 		if params2.Synchrony.MessageDelay != nil {
@@ -377,16 +695,98 @@ func (params ConsensusParams) Update(params2 *cmtproto.ConsensusParams) Consensu
 		}
 	}
 	if params2.Pbts != nil {
-		res.PBTS.PBTSEnableHeight = params2.Pbts.GetPbtsEnableHeight()
+		res.Feature = res.Feature.withHeight(FeaturePBTS, params2.Pbts.GetPbtsEnableHeight())
+	}
+	// params2.Features is the generic form of the same update and is applied
+	// last so it can set (or override) any feature, not just the two with a
+	// dedicated legacy message.
+	for _, fp := range params2.Features {
+		res.Feature = res.Feature.withHeight(fp.Name, fp.Height)
 	}
 	return res
 }
 
+// withHeight returns a copy of f with name's activation height set to
+// height, without modifying f.
+func (f FeatureParams) withHeight(name string, height int64) FeatureParams {
+	res := make(FeatureParams, len(f)+1)
+	for k, v := range f {
+		res[k] = v
+	}
+	res[name] = height
+	return res
+}
+
+// lanesToProto converts a slice of LaneParams to its proto representation,
+// returning nil for a nil or empty input so that "no lanes configured" round
+// trips without allocating an empty slice.
+func lanesToProto(lanes []LaneParams) []*cmtproto.LaneParams {
+	if len(lanes) == 0 {
+		return nil
+	}
+	pb := make([]*cmtproto.LaneParams, len(lanes))
+	for i, lane := range lanes {
+		pb[i] = &cmtproto.LaneParams{
+			Name:     lane.Name,
+			MaxBytes: lane.MaxBytes,
+			MaxGas:   lane.MaxGas,
+			Priority: lane.Priority,
+		}
+	}
+	return pb
+}
+
+// featuresToProto encodes every entry of f as the generic, order-independent
+// wire form. Entries are sorted by name so that two equal maps always
+// produce byte-identical proto output, regardless of Go map iteration
+// order.
+func featuresToProto(f FeatureParams) []*cmtproto.FeatureParam {
+	if len(f) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pb := make([]*cmtproto.FeatureParam, len(names))
+	for i, name := range names {
+		pb[i] = &cmtproto.FeatureParam{Name: name, Height: f[name]}
+	}
+	return pb
+}
+
+func featuresFromProto(pb []*cmtproto.FeatureParam) FeatureParams {
+	f := make(FeatureParams, len(pb))
+	for _, fp := range pb {
+		f[fp.Name] = fp.Height
+	}
+	return f
+}
+
+func lanesFromProto(pb []*cmtproto.LaneParams) []LaneParams {
+	if len(pb) == 0 {
+		return nil
+	}
+	lanes := make([]LaneParams, len(pb))
+	for i, lane := range pb {
+		lanes[i] = LaneParams{
+			Name:     lane.Name,
+			MaxBytes: lane.MaxBytes,
+			MaxGas:   lane.MaxGas,
+			Priority: lane.Priority,
+		}
+	}
+	return lanes
+}
+
 func (params *ConsensusParams) ToProto() cmtproto.ConsensusParams {
 	return cmtproto.ConsensusParams{
 		Block: &cmtproto.BlockParams{
 			MaxBytes: params.Block.MaxBytes,
 			MaxGas:   params.Block.MaxGas,
+			Lanes:    lanesToProto(params.Block.Lanes),
 		},
 		Evidence: &cmtproto.EvidenceParams{
 			MaxAgeNumBlocks: params.Evidence.MaxAgeNumBlocks,
@@ -400,15 +800,24 @@ func (params *ConsensusParams) ToProto() cmtproto.ConsensusParams {
 			App: params.Version.App,
 		},
 		Abci: &cmtproto.ABCIParams{
-			VoteExtensionsEnableHeight: params.ABCI.VoteExtensionsEnableHeight,
+			// Kept in sync with Feature[FeatureVoteExtensions] so that peers
+			// which don't yet understand the generic Features field below
+			// still see the right vote-extensions activation height.
+			VoteExtensionsEnableHeight: params.Feature[FeatureVoteExtensions],
 		},
 		Synchrony: &cmtproto.SynchronyParams{
 			MessageDelay: &params.Synchrony.MessageDelay,
 			Precision:    &params.Synchrony.Precision,
 		},
 		Pbts: &cmtproto.PBTSParams{
-			PbtsEnableHeight: params.PBTS.PBTSEnableHeight,
+			// Kept in sync with Feature[FeaturePBTS]; see the Abci comment
+			// above.
+			PbtsEnableHeight: params.Feature[FeaturePBTS],
 		},
+		// Features is the canonical, generic encoding of the whole map and
+		// is what a peer that understands this field should read from,
+		// including for vote_extensions/pbts.
+		Features: featuresToProto(params.Feature),
 	}
 }
 
@@ -417,6 +826,7 @@ func ConsensusParamsFromProto(pbParams cmtproto.ConsensusParams) ConsensusParams
 		Block: BlockParams{
 			MaxBytes: pbParams.Block.MaxBytes,
 			MaxGas:   pbParams.Block.MaxGas,
+			Lanes:    lanesFromProto(pbParams.Block.Lanes),
 		},
 		Evidence: EvidenceParams{
 			MaxAgeNumBlocks: pbParams.Evidence.MaxAgeNumBlocks,
@@ -430,8 +840,16 @@ func ConsensusParamsFromProto(pbParams cmtproto.ConsensusParams) ConsensusParams
 			App: pbParams.Version.App,
 		},
 	}
+
+	// The generic Features field is authoritative whenever it's present.
+	// Only fall back to the legacy Abci/Pbts fields for a name when
+	// Features didn't cover it, which happens when pbParams came from a
+	// peer that predates the Features field entirely.
+	c.Feature = featuresFromProto(pbParams.Features)
 	if pbParams.Abci != nil {
-		c.ABCI.VoteExtensionsEnableHeight = pbParams.Abci.GetVoteExtensionsEnableHeight()
+		if _, ok := c.Feature[FeatureVoteExtensions]; !ok {
+			c.Feature[FeatureVoteExtensions] = pbParams.Abci.GetVoteExtensionsEnableHeight()
+		}
 	}
 	if pbParams.Synchrony != nil {
 		if pbParams.Synchrony.MessageDelay != nil {
@@ -442,7 +860,9 @@ func ConsensusParamsFromProto(pbParams cmtproto.ConsensusParams) ConsensusParams
 		}
 	}
 	if pbParams.Pbts != nil {
-		c.PBTS.PBTSEnableHeight = pbParams.Pbts.GetPbtsEnableHeight()
+		if _, ok := c.Feature[FeaturePBTS]; !ok {
+			c.Feature[FeaturePBTS] = pbParams.Pbts.GetPbtsEnableHeight()
+		}
 	}
 	return c
 }
@@ -0,0 +1,280 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+)
+
+// TestBLS12381ValidatorMigration exercises upgrading a chain that only
+// allows ed25519 validators to one that also allows bls12381 validators, the
+// way a governance-approved ConsensusParams update would: the existing
+// PubKeyTypes list gains "bls12_381" and nothing else about the params
+// changes.
+func TestBLS12381ValidatorMigration(t *testing.T) {
+	original := DefaultConsensusParams()
+	require.NoError(t, original.ValidateBasic())
+	require.Equal(t, []string{ABCIPubKeyTypeEd25519}, original.Validator.PubKeyTypes)
+
+	update := &cmtproto.ConsensusParams{
+		Validator: &cmtproto.ValidatorParams{
+			PubKeyTypes: []string{ABCIPubKeyTypeEd25519, ABCIPubKeyTypeBLS12381},
+		},
+	}
+	migrated := original.Update(update)
+	require.NoError(t, migrated.ValidateBasic())
+	require.ElementsMatch(t, []string{ABCIPubKeyTypeEd25519, ABCIPubKeyTypeBLS12381}, migrated.Validator.PubKeyTypes)
+
+	// The original value must be unmodified (Update must not mutate in place).
+	require.Equal(t, []string{ABCIPubKeyTypeEd25519}, original.Validator.PubKeyTypes)
+
+	// An unknown pubkey type is still rejected.
+	bad := original
+	bad.Validator.PubKeyTypes = []string{ABCIPubKeyTypeEd25519, "unknown_type"}
+	require.Error(t, bad.ValidateBasic())
+}
+
+// TestValidateValidatorPubKeyUpdateRequiresPop checks that a bls12_381
+// validator pubkey is rejected without a valid proof-of-possession, even
+// though IsValidPubkeyType alone would accept it, and accepted once a real
+// proof is supplied.
+func TestValidateValidatorPubKeyUpdateRequiresPop(t *testing.T) {
+	params := ValidatorParams{PubKeyTypes: []string{ABCIPubKeyTypeEd25519, ABCIPubKeyTypeBLS12381}}
+
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey().(bls12381.PubKey)
+
+	require.Error(t, ValidateValidatorPubKeyUpdate(params, ABCIPubKeyTypeBLS12381, pubKey.Bytes(), nil))
+
+	forgedProof, err := privKey.Sign(pubKey.Bytes())
+	require.NoError(t, err)
+	require.Error(t, ValidateValidatorPubKeyUpdate(params, ABCIPubKeyTypeBLS12381, pubKey.Bytes(), forgedProof))
+
+	proof, err := privKey.PopProve()
+	require.NoError(t, err)
+	require.NoError(t, ValidateValidatorPubKeyUpdate(params, ABCIPubKeyTypeBLS12381, pubKey.Bytes(), proof))
+
+	// A pubkey type not present in params.PubKeyTypes is rejected regardless
+	// of pop.
+	require.Error(t, ValidateValidatorPubKeyUpdate(params, ABCIPubKeyTypeSecp256k1, []byte("whatever"), nil))
+
+	// Non-bls12381 types never need a pop.
+	require.NoError(t, ValidateValidatorPubKeyUpdate(params, ABCIPubKeyTypeEd25519, []byte("whatever"), nil))
+}
+
+func TestIsValidPubkeyTypeBLS12381(t *testing.T) {
+	params := ValidatorParams{PubKeyTypes: []string{ABCIPubKeyTypeEd25519, ABCIPubKeyTypeBLS12381}}
+	require.True(t, IsValidPubkeyType(params, ABCIPubKeyTypeBLS12381))
+	require.False(t, IsValidPubkeyType(params, ABCIPubKeyTypeSecp256k1))
+}
+
+// TestFeatureParamsProtoRoundTrip guards against silently dropping feature
+// activation heights across a ToProto/ConsensusParamsFromProto round trip,
+// which is exactly what state store save/load and the ABCI handshake do on
+// every restart. A feature with no dedicated legacy proto field (unlike
+// vote_extensions/pbts) must survive the round trip just the same.
+func TestFeatureParamsProtoRoundTrip(t *testing.T) {
+	const futureFeature = "some_future_feature"
+
+	params := *DefaultConsensusParams()
+	params.Feature = FeatureParams{
+		FeatureVoteExtensions: 100,
+		FeaturePBTS:           200,
+		futureFeature:         300,
+	}
+
+	pb := params.ToProto()
+	roundTripped := ConsensusParamsFromProto(pb)
+
+	require.Equal(t, params.Feature, roundTripped.Feature)
+	require.Equal(t, int64(300), roundTripped.Feature[futureFeature])
+
+	// The legacy fields must still be populated for old peers that only
+	// read Abci/Pbts and don't know about Features.
+	require.Equal(t, int64(100), pb.Abci.VoteExtensionsEnableHeight)
+	require.Equal(t, int64(200), pb.Pbts.PbtsEnableHeight)
+
+	// A peer that predates the Features field (an empty/nil slice) must
+	// still recover vote_extensions/pbts from the legacy fields.
+	pb.Features = nil
+	legacyOnly := ConsensusParamsFromProto(pb)
+	require.Equal(t, int64(100), legacyOnly.Feature[FeatureVoteExtensions])
+	require.Equal(t, int64(200), legacyOnly.Feature[FeaturePBTS])
+}
+
+// TestValidateUpdateFeaturePBTSLoosening pins down the intentional behavior
+// change unifying validateUpdateABCI and validateUpdatePBTS into
+// validateUpdateFeature: PBTS now allows resubmitting its own still-future
+// activation height, or moving it to a different still-future height,
+// which the pre-FeatureParams validateUpdatePBTS rejected outright once
+// enabled.
+func TestValidateUpdateFeaturePBTSLoosening(t *testing.T) {
+	params := *DefaultConsensusParams()
+	params.Feature[FeaturePBTS] = 100
+
+	// Resubmitting the same still-future height is now allowed.
+	require.NoError(t, params.ValidateUpdate(&cmtproto.ConsensusParams{
+		Pbts: &cmtproto.PBTSParams{PbtsEnableHeight: 100},
+	}, 10))
+
+	// Moving to a different still-future height is now allowed.
+	require.NoError(t, params.ValidateUpdate(&cmtproto.ConsensusParams{
+		Pbts: &cmtproto.PBTSParams{PbtsEnableHeight: 150},
+	}, 10))
+
+	// Disabling outright is still rejected.
+	require.Error(t, params.ValidateUpdate(&cmtproto.ConsensusParams{
+		Pbts: &cmtproto.PBTSParams{PbtsEnableHeight: 0},
+	}, 10))
+
+	// Modifying after activation has occurred is still rejected.
+	require.Error(t, params.ValidateUpdate(&cmtproto.ConsensusParams{
+		Pbts: &cmtproto.PBTSParams{PbtsEnableHeight: 200},
+	}, 150))
+}
+
+// TestHashVersioning checks that Hash(h) stays on the legacy v1 scheme
+// before FeatureMerkleizedParamsHash activates and switches to the v2
+// merkleized scheme at and after the activation height, and that
+// HashWithProof's root matches Hash(h) once v2 is active.
+func TestHashVersioning(t *testing.T) {
+	params := *DefaultConsensusParams()
+	params.Feature[FeatureMerkleizedParamsHash] = 100
+
+	before := params.Hash(99)
+	atActivation := params.Hash(100)
+	after := params.Hash(101)
+
+	require.NotEqual(t, before, atActivation, "hash scheme must switch at the activation height")
+	require.Equal(t, atActivation, after, "the v2 hash of unchanged params must be stable across heights")
+
+	root, proof, err := params.HashWithProof("Block")
+	require.NoError(t, err)
+	require.Equal(t, atActivation, root)
+	require.NoError(t, proof.Verify(root, mustMarshalForTest(t, params.ToProto().Block)))
+
+	_, _, err = params.HashWithProof("NotAField")
+	require.Error(t, err)
+}
+
+func mustMarshalForTest(t *testing.T, m interface{ Marshal() ([]byte, error) }) []byte {
+	t.Helper()
+	bz, err := m.Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestBlockParamsLanesValidation(t *testing.T) {
+	valid := *DefaultConsensusParams()
+	valid.Block.MaxBytes = 1000
+	valid.Block.MaxGas = 1000
+	valid.Evidence.MaxBytes = 100
+	valid.Block.Lanes = []LaneParams{
+		{Name: "oracle", MaxBytes: 200, MaxGas: 200, Priority: 10},
+		{Name: "user", MaxBytes: 800, MaxGas: 800, Priority: 1},
+	}
+	require.NoError(t, valid.ValidateBasic())
+
+	overBudget := valid
+	overBudget.Block.Lanes = []LaneParams{
+		{Name: "oracle", MaxBytes: 900},
+		{Name: "user", MaxBytes: 900},
+	}
+	require.Error(t, overBudget.ValidateBasic())
+
+	duplicateName := valid
+	duplicateName.Block.Lanes = []LaneParams{
+		{Name: "oracle", MaxBytes: 100},
+		{Name: "oracle", MaxBytes: 100},
+	}
+	require.Error(t, duplicateName.ValidateBasic())
+
+	emptyName := valid
+	emptyName.Block.Lanes = []LaneParams{{Name: "", MaxBytes: 100}}
+	require.Error(t, emptyName.ValidateBasic())
+}
+
+// TestBlockParamsLanesProtoRoundTrip checks Lanes survives ToProto/FromProto
+// and that nil Lanes means "no change" on Update, per the request.
+func TestBlockParamsLanesProtoRoundTrip(t *testing.T) {
+	params := *DefaultConsensusParams()
+	params.Block.Lanes = []LaneParams{
+		{Name: "ibc", MaxBytes: 1000, MaxGas: 1000, Priority: 5},
+	}
+
+	pb := params.ToProto()
+	roundTripped := ConsensusParamsFromProto(pb)
+	require.Equal(t, params.Block.Lanes, roundTripped.Block.Lanes)
+
+	// nil Lanes in the update means "no change".
+	noChange := params.Update(&cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: params.Block.MaxBytes, MaxGas: params.Block.MaxGas},
+	})
+	require.Equal(t, params.Block.Lanes, noChange.Block.Lanes)
+
+	// A non-nil (possibly empty) Lanes replaces the existing set.
+	cleared := params.Update(&cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{
+			MaxBytes: params.Block.MaxBytes,
+			MaxGas:   params.Block.MaxGas,
+			Lanes:    []*cmtproto.LaneParams{},
+		},
+	})
+	require.Empty(t, cleared.Block.Lanes)
+}
+
+// TestConsensusParamsJSONRoundTrip guards the genesis.json/RPC path, which
+// goes through plain encoding/json rather than the proto path covered by
+// TestFeatureParamsProtoRoundTrip. A round trip through the current format
+// must preserve Feature, and a genesis.json written before FeatureParams
+// existed (only the legacy "abci"/"pbts" keys, no "feature") must recover
+// the vote_extensions/pbts heights instead of silently resetting them.
+func TestConsensusParamsJSONRoundTrip(t *testing.T) {
+	params := *DefaultConsensusParams()
+	params.Feature = FeatureParams{
+		FeatureVoteExtensions: 100,
+		FeaturePBTS:           200,
+		"some_future_feature": 300,
+	}
+
+	bz, err := json.Marshal(params)
+	require.NoError(t, err)
+
+	var roundTripped ConsensusParams
+	require.NoError(t, json.Unmarshal(bz, &roundTripped))
+	require.Equal(t, params.Feature, roundTripped.Feature)
+
+	legacyGenesis := `{
+		"block": {"max_bytes": 4194304, "max_gas": 10000000},
+		"evidence": {"max_age_num_blocks": 100000, "max_age_duration": 172800000000000, "max_bytes": 1048576},
+		"validator": {"pub_key_types": ["ed25519"]},
+		"version": {"app": 0},
+		"abci": {"vote_extensions_enable_height": 100},
+		"synchrony": {"precision": "500000000", "message_delay": "2000000000"},
+		"pbts": {"pbts_enable_height": 200}
+	}`
+	var legacy ConsensusParams
+	require.NoError(t, json.Unmarshal([]byte(legacyGenesis), &legacy))
+	require.Equal(t, int64(100), legacy.Feature[FeatureVoteExtensions])
+	require.Equal(t, int64(200), legacy.Feature[FeaturePBTS])
+}
+
+// TestFeatureParamsUpdateGeneric checks that Update applies an arbitrary
+// feature from the generic Features field, not just vote_extensions/pbts.
+func TestFeatureParamsUpdateGeneric(t *testing.T) {
+	params := *DefaultConsensusParams()
+
+	updated := params.Update(&cmtproto.ConsensusParams{
+		Features: []*cmtproto.FeatureParam{
+			{Name: "some_future_feature", Height: 42},
+		},
+	})
+
+	require.Equal(t, int64(42), updated.Feature["some_future_feature"])
+	// params itself must be unmodified.
+	require.Zero(t, params.Feature["some_future_feature"])
+}